@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"sync"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/s3"
+)
+
+// Config holds the settings needed to build an AWSClient.
+type Config struct {
+	AccessKey string
+	SecretKey string
+	Region    string
+}
+
+// AWSClient holds the connections to the various AWS service APIs the
+// provider's resources are built against.
+type AWSClient struct {
+	region string
+	creds  aws.CredentialsProvider
+	s3conn *s3.S3
+
+	s3connLock     sync.Mutex
+	s3connByRegion map[string]*s3.S3
+}
+
+// Client returns a new AWSClient built from the Config.
+func (c *Config) Client() (interface{}, error) {
+	creds := aws.Creds(c.AccessKey, c.SecretKey, "")
+
+	client := &AWSClient{
+		region:         c.Region,
+		creds:          creds,
+		s3conn:         s3.New(creds, c.Region, nil),
+		s3connByRegion: make(map[string]*s3.S3),
+	}
+
+	return client, nil
+}
+
+// s3ConnForRegion returns an S3 client scoped to the given region, lazily
+// constructing and caching one if this is the first request for it. S3
+// buckets can live in a different region than the provider is configured
+// for, and the SDK signs/routes requests using the client's region, so a
+// mismatch surfaces as SigV4 or endpoint errors.
+func (c *AWSClient) s3ConnForRegion(region string) *s3.S3 {
+	if region == "" || region == c.region {
+		return c.s3conn
+	}
+
+	c.s3connLock.Lock()
+	defer c.s3connLock.Unlock()
+
+	if conn, ok := c.s3connByRegion[region]; ok {
+		return conn
+	}
+
+	conn := s3.New(c.creds, region, nil)
+	c.s3connByRegion[region] = conn
+
+	return conn
+}
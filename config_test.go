@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/s3"
+)
+
+func TestS3ConnForRegion(t *testing.T) {
+	creds := aws.Creds("key", "secret", "")
+	client := &AWSClient{
+		region:         "us-east-1",
+		creds:          creds,
+		s3conn:         s3.New(creds, "us-east-1", nil),
+		s3connByRegion: make(map[string]*s3.S3),
+	}
+
+	if got := client.s3ConnForRegion(""); got != client.s3conn {
+		t.Errorf("s3ConnForRegion(\"\") should return the home-region client")
+	}
+
+	if got := client.s3ConnForRegion("us-east-1"); got != client.s3conn {
+		t.Errorf("s3ConnForRegion(home region) should return the home-region client")
+	}
+
+	other := client.s3ConnForRegion("eu-west-1")
+	if other == client.s3conn {
+		t.Errorf("s3ConnForRegion(other region) should not return the home-region client")
+	}
+
+	if again := client.s3ConnForRegion("eu-west-1"); again != other {
+		t.Errorf("s3ConnForRegion should cache and reuse the per-region client")
+	}
+}
+
+func TestWebsiteDomain(t *testing.T) {
+	if got := websiteDomain("us-east-1"); got != "s3-website-us-east-1.amazonaws.com" {
+		t.Errorf("expected old-style website domain for us-east-1, got %q", got)
+	}
+
+	if got := websiteDomain("eu-central-1"); got != "s3-website.eu-central-1.amazonaws.com" {
+		t.Errorf("expected unified website domain for eu-central-1, got %q", got)
+	}
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/s3"
+)
+
+func dataSourceAwsS3Bucket() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsS3BucketRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"bucket_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hosted_zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"website_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"website_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	_, err := s3conn.HeadBucket(&s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("Failed getting S3 bucket (%s): %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	region, err := s3BucketRegion(s3conn, bucket, meta.(*AWSClient).region)
+	if err != nil {
+		return fmt.Errorf("Error getting S3 bucket location (%s): %s", bucket, err)
+	}
+
+	d.Set("region", region)
+	d.Set("arn", fmt.Sprintf("arn:aws:s3:::%s", bucket))
+	d.Set("bucket_domain_name", bucketDomainName(bucket))
+
+	if zoneID, ok := s3HostedZoneIDs[region]; ok {
+		d.Set("hosted_zone_id", zoneID)
+	}
+
+	website := websiteEndpoint(bucket, region)
+	d.Set("website_endpoint", website.Endpoint)
+	d.Set("website_domain", website.Domain)
+
+	return nil
+}
+
+func bucketDomainName(bucket string) string {
+	return fmt.Sprintf("%s.s3.amazonaws.com", bucket)
+}
+
+// s3BucketRegion discovers the region a bucket actually lives in.
+// GetBucketLocation returns an empty LocationConstraint for us-east-1.
+// Errors are returned unwrapped so callers can type-assert aws.APIError
+// (e.g. to detect a 404 for a bucket that no longer exists).
+func s3BucketRegion(s3conn *s3.S3, bucket, defaultRegion string) (string, error) {
+	resp, err := s3conn.GetBucketLocation(&s3.GetBucketLocationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if resp.LocationConstraint == nil || *resp.LocationConstraint == "" {
+		return "us-east-1", nil
+	}
+
+	return *resp.LocationConstraint, nil
+}
+
+// s3HostedZoneIDs maps S3 website regions to the Route53 hosted zone ID
+// used when aliasing to an S3 website endpoint.
+// See http://docs.aws.amazon.com/general/latest/gr/rande.html#s3_website_region_endpoints
+var s3HostedZoneIDs = map[string]string{
+	"us-east-1":      "Z3AQBSTGFYJSTF",
+	"us-west-1":      "Z2F56UZL2M1ACD",
+	"us-west-2":      "Z3BJ6K6RIION7M",
+	"eu-west-1":      "Z1BKCTXD74EZPE",
+	"eu-central-1":   "Z21DNDUVLTQW6Q",
+	"ap-southeast-1": "Z3O0J2DXBE1FTB",
+	"ap-southeast-2": "Z1WCIGYICN2BYD",
+	"ap-northeast-1": "Z2M4EHUR26P7ZW",
+	"sa-east-1":      "Z7KQH4QJS55SO",
+}
@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/s3"
+)
+
+// textLikeContentTypes matches content types whose body is safe to surface
+// as a Terraform string attribute.
+var textLikeContentTypes = regexp.MustCompile(`^(text/.*|application/json|application/.*\+json|application/xml|application/.*\+xml)$`)
+
+func dataSourceAwsS3BucketObject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsS3BucketObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"version_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cache_control": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_encoding": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_disposition": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_language": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"etag": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"storage_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"body": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	headInput := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if v, ok := d.GetOk("version_id"); ok {
+		headInput.VersionID = aws.String(v.(string))
+	}
+
+	resp, err := s3conn.HeadObject(headInput)
+	if err != nil {
+		return fmt.Errorf("Failed getting S3 bucket object (%s/%s): %s", bucket, key, err)
+	}
+
+	d.SetId(key)
+
+	if resp.CacheControl != nil {
+		d.Set("cache_control", *resp.CacheControl)
+	}
+	if resp.ContentType != nil {
+		d.Set("content_type", *resp.ContentType)
+	}
+	if resp.ContentEncoding != nil {
+		d.Set("content_encoding", *resp.ContentEncoding)
+	}
+	if resp.ContentDisposition != nil {
+		d.Set("content_disposition", *resp.ContentDisposition)
+	}
+	if resp.ContentLanguage != nil {
+		d.Set("content_language", *resp.ContentLanguage)
+	}
+	if resp.ContentLength != nil {
+		d.Set("content_length", int(*resp.ContentLength))
+	}
+	if resp.ETag != nil {
+		d.Set("etag", cleanEtag(*resp.ETag))
+	}
+	if resp.VersionID != nil {
+		d.Set("version_id", *resp.VersionID)
+	}
+
+	if resp.StorageClass != nil {
+		d.Set("storage_class", *resp.StorageClass)
+	} else {
+		d.Set("storage_class", s3.ObjectStorageClassStandard)
+	}
+
+	metadata := make(map[string]interface{}, len(resp.Metadata))
+	for k, v := range resp.Metadata {
+		if v != nil {
+			metadata[k] = *v
+		}
+	}
+	d.Set("metadata", metadata)
+
+	if resp.ContentType != nil && textLikeContentTypes.MatchString(*resp.ContentType) {
+		getInput := &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if v, ok := d.GetOk("version_id"); ok {
+			getInput.VersionID = aws.String(v.(string))
+		}
+
+		getResp, err := s3conn.GetObject(getInput)
+		if err != nil {
+			return fmt.Errorf("Failed getting S3 bucket object body (%s/%s): %s", bucket, key, err)
+		}
+		defer getResp.Body.Close()
+
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(getResp.Body); err != nil {
+			return fmt.Errorf("Failed reading S3 bucket object body (%s/%s): %s", bucket, key, err)
+		}
+
+		d.Set("body", buf.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns a terraform.ResourceProvider for AWS.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"access_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"secret_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_s3_bucket":        resourceAwsS3Bucket(),
+			"aws_s3_bucket_policy": resourceAwsS3BucketPolicy(),
+			"aws_s3_bucket_object": resourceAwsS3BucketObject(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_s3_bucket":        dataSourceAwsS3Bucket(),
+			"aws_s3_bucket_object": dataSourceAwsS3BucketObject(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := Config{
+		AccessKey: d.Get("access_key").(string),
+		SecretKey: d.Get("secret_key").(string),
+		Region:    d.Get("region").(string),
+	}
+
+	return config.Client()
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"log"
 
@@ -31,23 +33,295 @@ func resourceAwsS3Bucket() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"website": &schema.Schema{
-				Type:     schema.TypeBool,
-				Default:  false,
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
+				Computed: true,
+				ForceNew: true,
 			},
 
-			"index_document": &schema.Schema{
+			"arn": &schema.Schema{
 				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"bucket_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hosted_zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"website": &schema.Schema{
+				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: false,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"error_document": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"redirect_all_requests_to": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"routing_rules": &schema.Schema{
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"website.0.routing_rule"},
+						},
+
+						"routing_rule": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"website.0.routing_rules"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key_prefix_equals": &schema.Schema{
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"http_error_code_returned_equals": &schema.Schema{
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+
+									"redirect": &schema.Schema{
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"host_name": &schema.Schema{
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"protocol": &schema.Schema{
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"replace_key_prefix_with": &schema.Schema{
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"replace_key_with": &schema.Schema{
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"http_redirect_code": &schema.Schema{
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"website_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 
-			"error_document": &schema.Schema{
+			"website_domain": &schema.Schema{
 				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versioning": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"mfa_delete": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"logging": &schema.Schema{
+				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: false,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_bucket": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"target_prefix": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"lifecycle_rule": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"prefix": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"enabled": &schema.Schema{
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"abort_incomplete_multipart_upload_days": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"expiration": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"days": &schema.Schema{
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"expired_object_delete_marker": &schema.Schema{
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"noncurrent_version_expiration": &schema.Schema{
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": &schema.Schema{
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"transition": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"days": &schema.Schema{
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+
+									"storage_class": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"cors_rule": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"allowed_methods": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"allowed_origins": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"expose_headers": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"max_age_seconds": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
 			},
 
 			"tags": tagsSchema(),
@@ -56,13 +330,18 @@ func resourceAwsS3Bucket() *schema.Resource {
 }
 
 func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
-	awsRegion := meta.(*AWSClient).region
+	client := meta.(*AWSClient)
+	s3conn := client.s3conn
 
 	// Get the bucket and acl
 	bucket := d.Get("bucket").(string)
 	acl := d.Get("acl").(string)
 
+	awsRegion := d.Get("region").(string)
+	if awsRegion == "" {
+		awsRegion = client.region
+	}
+
 	log.Printf("[DEBUG] S3 bucket create: %s, ACL: %s", bucket, acl)
 
 	req := &s3.CreateBucketInput{
@@ -85,37 +364,79 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 
 	// Assign the bucket name as the resource ID
 	d.SetId(bucket)
+	d.Set("region", awsRegion)
 
 	return resourceAwsS3BucketUpdate(d, meta)
 }
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	client := meta.(*AWSClient)
+	s3conn := client.s3ConnForRegion(d.Get("region").(string))
+
 	if err := setTagsS3(s3conn, d); err != nil {
 		return err
 	}
 
-	if err := updateWebsite(s3conn, d); err != nil {
-		return err
+	if d.HasChange("website") {
+		if err := updateWebsite(s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("versioning") {
+		if err := updateVersioning(s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("logging") {
+		if err := updateLogging(s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("lifecycle_rule") {
+		if err := updateLifecycle(s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("cors_rule") {
+		if err := updateCors(s3conn, d); err != nil {
+			return err
+		}
 	}
 
 	return resourceAwsS3BucketRead(d, meta)
 }
 
 func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	client := meta.(*AWSClient)
 
-	_, err := s3conn.HeadBucket(&s3.HeadBucketInput{
-		Bucket: aws.String(d.Id()),
-	})
+	// The bucket can live in a different region than the provider, so the
+	// existence check and region discovery have to happen together: a
+	// HeadBucket against the provider's home-region client fails with a
+	// SigV4/endpoint mismatch (not a 404) for a bucket in another region,
+	// and s3BucketRegion's GetBucketLocation call is the one S3 API that
+	// resolves correctly regardless of which region's client makes it.
+	awsRegion, err := s3BucketRegion(client.s3conn, d.Id(), client.region)
 	if err != nil {
 		if awsError, ok := err.(aws.APIError); ok && awsError.StatusCode == 404 {
 			d.SetId("")
-		} else {
-			// some of the AWS SDK's errors can be empty strings, so let's add
-			// some additional context.
-			return fmt.Errorf("error reading S3 bucket \"%s\": %s", d.Id(), err)
+			return nil
 		}
+		// some of the AWS SDK's errors can be empty strings, so let's add
+		// some additional context.
+		return fmt.Errorf("error reading S3 bucket \"%s\": %s", d.Id(), err)
+	}
+	d.Set("region", awsRegion)
+
+	s3conn := client.s3ConnForRegion(awsRegion)
+
+	d.Set("arn", fmt.Sprintf("arn:aws:s3:::%s", d.Id()))
+	d.Set("bucket_domain_name", bucketDomainName(d.Id()))
+	if zoneID, ok := s3HostedZoneIDs[awsRegion]; ok {
+		d.Set("hosted_zone_id", zoneID)
 	}
 
 	tagSet, err := getTagSetS3(s3conn, d.Id())
@@ -127,11 +448,78 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	websiteRaw, err := s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isS3NotConfiguredErr(err, "NoSuchWebsiteConfiguration") {
+			d.Set("website", nil)
+		} else {
+			return fmt.Errorf("error getting S3 bucket website configuration: %s", err)
+		}
+	} else {
+		website, err := flattenWebsite(websiteRaw, d.Get("website").([]interface{}))
+		if err != nil {
+			return err
+		}
+		if err := d.Set("website", website); err != nil {
+			return err
+		}
+	}
+
+	websiteEndpoint := websiteEndpoint(d.Id(), awsRegion)
+	d.Set("website_endpoint", websiteEndpoint.Endpoint)
+	d.Set("website_domain", websiteEndpoint.Domain)
+
+	versioning, err := s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 bucket versioning: %s", err)
+	}
+	if err := d.Set("versioning", flattenVersioning(versioning)); err != nil {
+		return err
+	}
+
+	logging, err := s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 bucket logging: %s", err)
+	}
+	if err := d.Set("logging", flattenLogging(logging)); err != nil {
+		return err
+	}
+
+	lifecycle, err := s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if !isS3NotConfiguredErr(err, "NoSuchLifecycleConfiguration") {
+			return fmt.Errorf("error getting S3 bucket lifecycle configuration: %s", err)
+		}
+		d.Set("lifecycle_rule", nil)
+	} else if err := d.Set("lifecycle_rule", flattenLifecycleRules(lifecycle.Rules)); err != nil {
+		return err
+	}
+
+	cors, err := s3conn.GetBucketCors(&s3.GetBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if !isS3NotConfiguredErr(err, "NoSuchCORSConfiguration") {
+			return fmt.Errorf("error getting S3 bucket CORS configuration: %s", err)
+		}
+		d.Set("cors_rule", nil)
+	} else if err := d.Set("cors_rule", flattenCorsRules(cors.CORSRules)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3ConnForRegion(d.Get("region").(string))
 
 	log.Printf("[DEBUG] S3 Delete Bucket: %s", d.Id())
 	_, err := s3conn.DeleteBucket(&s3.DeleteBucketInput{
@@ -144,43 +532,748 @@ func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func updateWebsite(s3conn *s3.S3, d *schema.ResourceData) error {
-	website := d.Get("website").(bool)
 	bucket := d.Get("bucket").(string)
-	indexDocument := d.Get("index_document").(string)
-	errorDocument := d.Get("error_document").(string)
+	ws := d.Get("website").([]interface{})
+
+	if len(ws) == 0 {
+		deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
-	if website {
-		websiteConfiguration := &s3.WebsiteConfiguration{}
+		log.Printf("[DEBUG] S3 delete bucket website: %s", deleteInput)
 
-		if indexDocument != "" {
-			websiteConfiguration.IndexDocument = &s3.IndexDocument{Suffix: aws.String(indexDocument)}
+		_, err := s3conn.DeleteBucketWebsite(deleteInput)
+		if err != nil {
+			return fmt.Errorf("Error deleting S3 website: %s", err)
 		}
+		return nil
+	}
 
-		if errorDocument != "" {
-			websiteConfiguration.ErrorDocument = &s3.ErrorDocument{Key: aws.String(errorDocument)}
+	var w map[string]interface{}
+	if ws[0] != nil {
+		w = ws[0].(map[string]interface{})
+	}
+
+	websiteConfiguration := &s3.WebsiteConfiguration{}
+
+	if v, ok := w["index_document"]; ok && v.(string) != "" {
+		websiteConfiguration.IndexDocument = &s3.IndexDocument{Suffix: aws.String(v.(string))}
+	}
+
+	if v, ok := w["error_document"]; ok && v.(string) != "" {
+		websiteConfiguration.ErrorDocument = &s3.ErrorDocument{Key: aws.String(v.(string))}
+	}
+
+	if v, ok := w["redirect_all_requests_to"]; ok && v.(string) != "" {
+		redirect, err := expandWebsiteRedirect(v.(string))
+		if err != nil {
+			return err
 		}
+		websiteConfiguration.RedirectAllRequestsTo = redirect
+	}
 
-		putInput := &s3.PutBucketWebsiteInput{
-			Bucket:               aws.String(bucket),
-			WebsiteConfiguration: websiteConfiguration,
+	if v, ok := w["routing_rules"]; ok && v.(string) != "" {
+		rules, err := expandWebsiteRoutingRules(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error expanding routing_rules: %s", err)
 		}
+		websiteConfiguration.RoutingRules = rules
+	} else if v, ok := w["routing_rule"].([]interface{}); ok && len(v) > 0 {
+		websiteConfiguration.RoutingRules = expandWebsiteRoutingRulesFromList(v)
+	}
+
+	putInput := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: websiteConfiguration,
+	}
 
-		log.Printf("[DEBUG] S3 put bucket website: %s", putInput)
+	log.Printf("[DEBUG] S3 put bucket website: %s", putInput)
 
-		_, err := s3conn.PutBucketWebsite(putInput)
-		if err != nil {
-			return fmt.Errorf("Error putting S3 website: %s", err)
+	_, err := s3conn.PutBucketWebsite(putInput)
+	if err != nil {
+		return fmt.Errorf("Error putting S3 website: %s", err)
+	}
+
+	return nil
+}
+
+// expandWebsiteRedirect turns a "protocol://host" or bare "host" string into
+// an s3.RedirectAllRequestsTo. The protocol defaults to unset, letting S3
+// preserve the scheme of the original request.
+func expandWebsiteRedirect(raw string) (*s3.RedirectAllRequestsTo, error) {
+	host := raw
+	var protocol *string
+
+	if idx := bytes.Index([]byte(raw), []byte("://")); idx != -1 {
+		protocol = aws.String(raw[:idx])
+		host = raw[idx+len("://"):]
+	}
+
+	return &s3.RedirectAllRequestsTo{
+		HostName: aws.String(host),
+		Protocol: protocol,
+	}, nil
+}
+
+func flattenWebsiteRedirect(r *s3.RedirectAllRequestsTo) string {
+	if r == nil || r.HostName == nil {
+		return ""
+	}
+
+	if r.Protocol != nil && *r.Protocol != "" {
+		return fmt.Sprintf("%s://%s", *r.Protocol, *r.HostName)
+	}
+
+	return *r.HostName
+}
+
+type websiteRoutingRuleCondition struct {
+	KeyPrefixEquals             string `json:"KeyPrefixEquals,omitempty"`
+	HttpErrorCodeReturnedEquals string `json:"HttpErrorCodeReturnedEquals,omitempty"`
+}
+
+type websiteRoutingRuleRedirect struct {
+	ReplaceKeyWith       string `json:"ReplaceKeyWith,omitempty"`
+	ReplaceKeyPrefixWith string `json:"ReplaceKeyPrefixWith,omitempty"`
+	HostName             string `json:"HostName,omitempty"`
+	Protocol             string `json:"Protocol,omitempty"`
+	HttpRedirectCode     string `json:"HttpRedirectCode,omitempty"`
+}
+
+type websiteRoutingRule struct {
+	Condition *websiteRoutingRuleCondition `json:"Condition,omitempty"`
+	Redirect  websiteRoutingRuleRedirect   `json:"Redirect"`
+}
+
+func expandWebsiteRoutingRules(raw string) ([]*s3.RoutingRule, error) {
+	var rawRules []websiteRoutingRule
+	if err := json.Unmarshal([]byte(raw), &rawRules); err != nil {
+		return nil, err
+	}
+
+	rules := make([]*s3.RoutingRule, 0, len(rawRules))
+	for _, r := range rawRules {
+		rule := &s3.RoutingRule{
+			Redirect: &s3.Redirect{},
 		}
-	} else {
-		deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
-		log.Printf("[DEBUG] S3 delete bucket website: %s", deleteInput)
+		if r.Condition != nil {
+			rule.Condition = &s3.Condition{}
+			if r.Condition.KeyPrefixEquals != "" {
+				rule.Condition.KeyPrefixEquals = aws.String(r.Condition.KeyPrefixEquals)
+			}
+			if r.Condition.HttpErrorCodeReturnedEquals != "" {
+				rule.Condition.HTTPErrorCodeReturnedEquals = aws.String(r.Condition.HttpErrorCodeReturnedEquals)
+			}
+		}
 
-		_, err := s3conn.DeleteBucketWebsite(deleteInput)
+		if r.Redirect.ReplaceKeyWith != "" {
+			rule.Redirect.ReplaceKeyWith = aws.String(r.Redirect.ReplaceKeyWith)
+		}
+		if r.Redirect.ReplaceKeyPrefixWith != "" {
+			rule.Redirect.ReplaceKeyPrefixWith = aws.String(r.Redirect.ReplaceKeyPrefixWith)
+		}
+		if r.Redirect.HostName != "" {
+			rule.Redirect.HostName = aws.String(r.Redirect.HostName)
+		}
+		if r.Redirect.Protocol != "" {
+			rule.Redirect.Protocol = aws.String(r.Redirect.Protocol)
+		}
+		if r.Redirect.HttpRedirectCode != "" {
+			rule.Redirect.HTTPRedirectCode = aws.String(r.Redirect.HttpRedirectCode)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func flattenWebsiteRoutingRules(rules []*s3.RoutingRule) (string, error) {
+	if len(rules) == 0 {
+		return "", nil
+	}
+
+	rawRules := make([]websiteRoutingRule, 0, len(rules))
+	for _, rule := range rules {
+		r := websiteRoutingRule{}
+
+		if rule.Condition != nil {
+			r.Condition = &websiteRoutingRuleCondition{}
+			if rule.Condition.KeyPrefixEquals != nil {
+				r.Condition.KeyPrefixEquals = *rule.Condition.KeyPrefixEquals
+			}
+			if rule.Condition.HTTPErrorCodeReturnedEquals != nil {
+				r.Condition.HttpErrorCodeReturnedEquals = *rule.Condition.HTTPErrorCodeReturnedEquals
+			}
+		}
+
+		if rule.Redirect != nil {
+			if rule.Redirect.ReplaceKeyWith != nil {
+				r.Redirect.ReplaceKeyWith = *rule.Redirect.ReplaceKeyWith
+			}
+			if rule.Redirect.ReplaceKeyPrefixWith != nil {
+				r.Redirect.ReplaceKeyPrefixWith = *rule.Redirect.ReplaceKeyPrefixWith
+			}
+			if rule.Redirect.HostName != nil {
+				r.Redirect.HostName = *rule.Redirect.HostName
+			}
+			if rule.Redirect.Protocol != nil {
+				r.Redirect.Protocol = *rule.Redirect.Protocol
+			}
+			if rule.Redirect.HTTPRedirectCode != nil {
+				r.Redirect.HttpRedirectCode = *rule.Redirect.HTTPRedirectCode
+			}
+		}
+
+		rawRules = append(rawRules, r)
+	}
+
+	data, err := json.Marshal(rawRules)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// expandWebsiteRoutingRulesFromList is the "routing_rule" nested-block
+// counterpart to expandWebsiteRoutingRules, for users who'd rather not
+// hand-write the RoutingRules JSON document.
+func expandWebsiteRoutingRulesFromList(rulesRaw []interface{}) []*s3.RoutingRule {
+	rules := make([]*s3.RoutingRule, 0, len(rulesRaw))
+
+	for _, ruleRaw := range rulesRaw {
+		r := ruleRaw.(map[string]interface{})
+
+		rule := &s3.RoutingRule{
+			Redirect: &s3.Redirect{},
+		}
+
+		if v, ok := r["condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			c := v[0].(map[string]interface{})
+			rule.Condition = &s3.Condition{}
+			if val := c["key_prefix_equals"].(string); val != "" {
+				rule.Condition.KeyPrefixEquals = aws.String(val)
+			}
+			if val := c["http_error_code_returned_equals"].(string); val != "" {
+				rule.Condition.HTTPErrorCodeReturnedEquals = aws.String(val)
+			}
+		}
+
+		if v, ok := r["redirect"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			rd := v[0].(map[string]interface{})
+			if val := rd["replace_key_with"].(string); val != "" {
+				rule.Redirect.ReplaceKeyWith = aws.String(val)
+			}
+			if val := rd["replace_key_prefix_with"].(string); val != "" {
+				rule.Redirect.ReplaceKeyPrefixWith = aws.String(val)
+			}
+			if val := rd["host_name"].(string); val != "" {
+				rule.Redirect.HostName = aws.String(val)
+			}
+			if val := rd["protocol"].(string); val != "" {
+				rule.Redirect.Protocol = aws.String(val)
+			}
+			if val := rd["http_redirect_code"].(string); val != "" {
+				rule.Redirect.HTTPRedirectCode = aws.String(val)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// flattenWebsiteRoutingRulesToList is the inverse of
+// expandWebsiteRoutingRulesFromList.
+func flattenWebsiteRoutingRulesToList(rules []*s3.RoutingRule) []map[string]interface{} {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		r := map[string]interface{}{}
+
+		if rule.Condition != nil {
+			c := map[string]interface{}{}
+			if rule.Condition.KeyPrefixEquals != nil {
+				c["key_prefix_equals"] = *rule.Condition.KeyPrefixEquals
+			}
+			if rule.Condition.HTTPErrorCodeReturnedEquals != nil {
+				c["http_error_code_returned_equals"] = *rule.Condition.HTTPErrorCodeReturnedEquals
+			}
+			r["condition"] = []map[string]interface{}{c}
+		}
+
+		if rule.Redirect != nil {
+			rd := map[string]interface{}{}
+			if rule.Redirect.ReplaceKeyWith != nil {
+				rd["replace_key_with"] = *rule.Redirect.ReplaceKeyWith
+			}
+			if rule.Redirect.ReplaceKeyPrefixWith != nil {
+				rd["replace_key_prefix_with"] = *rule.Redirect.ReplaceKeyPrefixWith
+			}
+			if rule.Redirect.HostName != nil {
+				rd["host_name"] = *rule.Redirect.HostName
+			}
+			if rule.Redirect.Protocol != nil {
+				rd["protocol"] = *rule.Redirect.Protocol
+			}
+			if rule.Redirect.HTTPRedirectCode != nil {
+				rd["http_redirect_code"] = *rule.Redirect.HTTPRedirectCode
+			}
+			r["redirect"] = []map[string]interface{}{rd}
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// flattenWebsite turns a GetBucketWebsiteOutput into the nested "website"
+// block. existing is the block's prior state, used only to decide whether
+// routing rules should round-trip as the "routing_rules" JSON string or the
+// "routing_rule" nested list - whichever the config last used - so that
+// switching representations doesn't require also touching the other.
+func flattenWebsite(ws *s3.GetBucketWebsiteOutput, existing []interface{}) ([]map[string]interface{}, error) {
+	if ws.IndexDocument == nil && ws.ErrorDocument == nil && ws.RedirectAllRequestsTo == nil && len(ws.RoutingRules) == 0 {
+		return nil, nil
+	}
+
+	w := make(map[string]interface{})
+
+	if ws.IndexDocument != nil && ws.IndexDocument.Suffix != nil {
+		w["index_document"] = *ws.IndexDocument.Suffix
+	}
+
+	if ws.ErrorDocument != nil && ws.ErrorDocument.Key != nil {
+		w["error_document"] = *ws.ErrorDocument.Key
+	}
+
+	if ws.RedirectAllRequestsTo != nil {
+		w["redirect_all_requests_to"] = flattenWebsiteRedirect(ws.RedirectAllRequestsTo)
+	}
+
+	if usedRoutingRuleBlock(existing) {
+		if routingRule := flattenWebsiteRoutingRulesToList(ws.RoutingRules); len(routingRule) > 0 {
+			w["routing_rule"] = routingRule
+		}
+	} else {
+		routingRules, err := flattenWebsiteRoutingRules(ws.RoutingRules)
 		if err != nil {
-			return fmt.Errorf("Error deleting S3 website: %s", err)
+			return nil, fmt.Errorf("Error flattening routing_rules: %s", err)
+		}
+		if routingRules != "" {
+			w["routing_rules"] = routingRules
 		}
 	}
 
+	return []map[string]interface{}{w}, nil
+}
+
+// usedRoutingRuleBlock reports whether the prior "website" block state was
+// configured with the "routing_rule" nested list rather than the
+// "routing_rules" JSON string.
+func usedRoutingRuleBlock(existing []interface{}) bool {
+	if len(existing) == 0 || existing[0] == nil {
+		return false
+	}
+
+	w, ok := existing[0].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	rr, ok := w["routing_rule"].([]interface{})
+	return ok && len(rr) > 0
+}
+
+// S3Website holds the endpoint and bare domain a bucket's static website is
+// served from, which varies by region.
+type S3Website struct {
+	Endpoint string
+	Domain   string
+}
+
+// oldS3WebsiteRegions predates the unified "s3-website.<region>.amazonaws.com"
+// endpoint format and instead uses "s3-website-<region>.amazonaws.com".
+var oldS3WebsiteRegions = map[string]bool{
+	"us-east-1":      true,
+	"us-west-1":      true,
+	"us-west-2":      true,
+	"eu-west-1":      true,
+	"ap-southeast-1": true,
+	"ap-southeast-2": true,
+	"ap-northeast-1": true,
+	"sa-east-1":      true,
+}
+
+func websiteDomain(region string) string {
+	if oldS3WebsiteRegions[region] {
+		return fmt.Sprintf("s3-website-%s.amazonaws.com", region)
+	}
+	return fmt.Sprintf("s3-website.%s.amazonaws.com", region)
+}
+
+func websiteEndpoint(bucket, region string) *S3Website {
+	domain := websiteDomain(region)
+	return &S3Website{
+		Endpoint: fmt.Sprintf("%s.%s", bucket, domain),
+		Domain:   domain,
+	}
+}
+
+func isS3NotConfiguredErr(err error, code string) bool {
+	awsError, ok := err.(aws.APIError)
+	return ok && awsError.Code == code
+}
+
+func updateVersioning(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	v := d.Get("versioning").([]interface{})
+
+	vc := &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusSuspended)}
+
+	if len(v) > 0 && v[0] != nil {
+		c := v[0].(map[string]interface{})
+		if c["enabled"].(bool) {
+			vc.Status = aws.String(s3.BucketVersioningStatusEnabled)
+		}
+		if c["mfa_delete"].(bool) {
+			vc.MFADelete = aws.String(s3.MFADeleteStatusEnabled)
+		} else {
+			vc.MFADelete = aws.String(s3.MFADeleteStatusDisabled)
+		}
+	}
+
+	putInput := &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: vc,
+	}
+
+	log.Printf("[DEBUG] S3 put bucket versioning: %s", putInput)
+
+	if _, err := s3conn.PutBucketVersioning(putInput); err != nil {
+		return fmt.Errorf("Error putting S3 versioning: %s", err)
+	}
+
+	return nil
+}
+
+func flattenVersioning(v *s3.GetBucketVersioningOutput) []map[string]interface{} {
+	if v == nil || (v.Status == nil && v.MFADelete == nil) {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"enabled":    v.Status != nil && *v.Status == s3.BucketVersioningStatusEnabled,
+			"mfa_delete": v.MFADelete != nil && *v.MFADelete == s3.MFADeleteStatusEnabled,
+		},
+	}
+}
+
+func updateLogging(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	l := d.Get("logging").([]interface{})
+
+	loggingStatus := &s3.BucketLoggingStatus{}
+
+	if len(l) > 0 && l[0] != nil {
+		c := l[0].(map[string]interface{})
+
+		loggingEnabled := &s3.LoggingEnabled{}
+		if v, ok := c["target_bucket"]; ok {
+			loggingEnabled.TargetBucket = aws.String(v.(string))
+		}
+		if v, ok := c["target_prefix"]; ok {
+			loggingEnabled.TargetPrefix = aws.String(v.(string))
+		}
+
+		loggingStatus.LoggingEnabled = loggingEnabled
+	}
+
+	putInput := &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(bucket),
+		BucketLoggingStatus: loggingStatus,
+	}
+
+	log.Printf("[DEBUG] S3 put bucket logging: %s", putInput)
+
+	if _, err := s3conn.PutBucketLogging(putInput); err != nil {
+		return fmt.Errorf("Error putting S3 logging: %s", err)
+	}
+
 	return nil
 }
+
+func flattenLogging(l *s3.GetBucketLoggingOutput) []map[string]interface{} {
+	if l == nil || l.LoggingEnabled == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+	if l.LoggingEnabled.TargetBucket != nil {
+		m["target_bucket"] = *l.LoggingEnabled.TargetBucket
+	}
+	if l.LoggingEnabled.TargetPrefix != nil {
+		m["target_prefix"] = *l.LoggingEnabled.TargetPrefix
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func updateLifecycle(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	rules := d.Get("lifecycle_rule").([]interface{})
+
+	if len(rules) == 0 {
+		deleteInput := &s3.DeleteBucketLifecycleInput{Bucket: aws.String(bucket)}
+
+		log.Printf("[DEBUG] S3 delete bucket lifecycle: %s", deleteInput)
+
+		if _, err := s3conn.DeleteBucketLifecycle(deleteInput); err != nil {
+			return fmt.Errorf("Error deleting S3 lifecycle: %s", err)
+		}
+		return nil
+	}
+
+	lifecycleRules := make([]*s3.LifecycleRule, 0, len(rules))
+
+	for _, ruleRaw := range rules {
+		r := ruleRaw.(map[string]interface{})
+
+		rule := &s3.LifecycleRule{
+			Prefix: aws.String(r["prefix"].(string)),
+		}
+
+		if r["enabled"].(bool) {
+			rule.Status = aws.String(s3.ExpirationStatusEnabled)
+		} else {
+			rule.Status = aws.String(s3.ExpirationStatusDisabled)
+		}
+
+		if v, ok := r["id"]; ok && v.(string) != "" {
+			rule.ID = aws.String(v.(string))
+		}
+
+		if v, ok := r["abort_incomplete_multipart_upload_days"]; ok && v.(int) > 0 {
+			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Long(int64(v.(int))),
+			}
+		}
+
+		if v, ok := r["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			e := v[0].(map[string]interface{})
+			expiration := &s3.LifecycleExpiration{}
+
+			if val := e["date"].(string); val != "" {
+				expiration.Date = aws.String(val)
+			}
+			if val := e["days"].(int); val > 0 {
+				expiration.Days = aws.Long(int64(val))
+			}
+			if val := e["expired_object_delete_marker"].(bool); val {
+				expiration.ExpiredObjectDeleteMarker = aws.Boolean(val)
+			}
+
+			rule.Expiration = expiration
+		}
+
+		if v, ok := r["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			e := v[0].(map[string]interface{})
+			if val := e["days"].(int); val > 0 {
+				rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+					NoncurrentDays: aws.Long(int64(val)),
+				}
+			}
+		}
+
+		if v, ok := r["transition"].([]interface{}); ok && len(v) > 0 {
+			transitions := make([]*s3.Transition, 0, len(v))
+			for _, tRaw := range v {
+				t := tRaw.(map[string]interface{})
+				transition := &s3.Transition{
+					StorageClass: aws.String(t["storage_class"].(string)),
+				}
+				if val := t["date"].(string); val != "" {
+					transition.Date = aws.String(val)
+				}
+				if val := t["days"].(int); val > 0 {
+					transition.Days = aws.Long(int64(val))
+				}
+				transitions = append(transitions, transition)
+			}
+			rule.Transitions = transitions
+		}
+
+		lifecycleRules = append(lifecycleRules, rule)
+	}
+
+	putInput := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.LifecycleConfiguration{
+			Rules: lifecycleRules,
+		},
+	}
+
+	log.Printf("[DEBUG] S3 put bucket lifecycle configuration: %s", putInput)
+
+	if _, err := s3conn.PutBucketLifecycleConfiguration(putInput); err != nil {
+		return fmt.Errorf("Error putting S3 lifecycle: %s", err)
+	}
+
+	return nil
+}
+
+func flattenLifecycleRules(rules []*s3.LifecycleRule) []map[string]interface{} {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		r := map[string]interface{}{
+			"enabled": rule.Status != nil && *rule.Status == s3.ExpirationStatusEnabled,
+		}
+
+		if rule.ID != nil {
+			r["id"] = *rule.ID
+		}
+		if rule.Prefix != nil {
+			r["prefix"] = *rule.Prefix
+		}
+		if rule.AbortIncompleteMultipartUpload != nil && rule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+			r["abort_incomplete_multipart_upload_days"] = int(*rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+		}
+
+		if rule.Expiration != nil {
+			e := map[string]interface{}{}
+			if rule.Expiration.Date != nil {
+				e["date"] = *rule.Expiration.Date
+			}
+			if rule.Expiration.Days != nil {
+				e["days"] = int(*rule.Expiration.Days)
+			}
+			if rule.Expiration.ExpiredObjectDeleteMarker != nil {
+				e["expired_object_delete_marker"] = *rule.Expiration.ExpiredObjectDeleteMarker
+			}
+			r["expiration"] = []map[string]interface{}{e}
+		}
+
+		if rule.NoncurrentVersionExpiration != nil && rule.NoncurrentVersionExpiration.NoncurrentDays != nil {
+			r["noncurrent_version_expiration"] = []map[string]interface{}{
+				{"days": int(*rule.NoncurrentVersionExpiration.NoncurrentDays)},
+			}
+		}
+
+		if len(rule.Transitions) > 0 {
+			transitions := make([]map[string]interface{}, 0, len(rule.Transitions))
+			for _, t := range rule.Transitions {
+				tm := map[string]interface{}{}
+				if t.Date != nil {
+					tm["date"] = *t.Date
+				}
+				if t.Days != nil {
+					tm["days"] = int(*t.Days)
+				}
+				if t.StorageClass != nil {
+					tm["storage_class"] = *t.StorageClass
+				}
+				transitions = append(transitions, tm)
+			}
+			r["transition"] = transitions
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func updateCors(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	rules := d.Get("cors_rule").([]interface{})
+
+	if len(rules) == 0 {
+		deleteInput := &s3.DeleteBucketCorsInput{Bucket: aws.String(bucket)}
+
+		log.Printf("[DEBUG] S3 delete bucket CORS: %s", deleteInput)
+
+		if _, err := s3conn.DeleteBucketCors(deleteInput); err != nil {
+			return fmt.Errorf("Error deleting S3 CORS: %s", err)
+		}
+		return nil
+	}
+
+	corsRules := make([]*s3.CORSRule, 0, len(rules))
+
+	for _, ruleRaw := range rules {
+		r := ruleRaw.(map[string]interface{})
+
+		rule := &s3.CORSRule{
+			AllowedMethods: expandStringList(r["allowed_methods"].([]interface{})),
+			AllowedOrigins: expandStringList(r["allowed_origins"].([]interface{})),
+		}
+
+		if v, ok := r["allowed_headers"].([]interface{}); ok && len(v) > 0 {
+			rule.AllowedHeaders = expandStringList(v)
+		}
+		if v, ok := r["expose_headers"].([]interface{}); ok && len(v) > 0 {
+			rule.ExposeHeaders = expandStringList(v)
+		}
+		if v, ok := r["max_age_seconds"].(int); ok && v > 0 {
+			rule.MaxAgeSeconds = aws.Long(int64(v))
+		}
+
+		corsRules = append(corsRules, rule)
+	}
+
+	putInput := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: corsRules,
+		},
+	}
+
+	log.Printf("[DEBUG] S3 put bucket CORS: %s", putInput)
+
+	if _, err := s3conn.PutBucketCors(putInput); err != nil {
+		return fmt.Errorf("Error putting S3 CORS: %s", err)
+	}
+
+	return nil
+}
+
+func flattenCorsRules(rules []*s3.CORSRule) []map[string]interface{} {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		r := map[string]interface{}{
+			"allowed_methods": flattenStringList(rule.AllowedMethods),
+			"allowed_origins": flattenStringList(rule.AllowedOrigins),
+		}
+
+		if len(rule.AllowedHeaders) > 0 {
+			r["allowed_headers"] = flattenStringList(rule.AllowedHeaders)
+		}
+		if len(rule.ExposeHeaders) > 0 {
+			r["expose_headers"] = flattenStringList(rule.ExposeHeaders)
+		}
+		if rule.MaxAgeSeconds != nil {
+			r["max_age_seconds"] = int(*rule.MaxAgeSeconds)
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
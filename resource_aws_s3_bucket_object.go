@@ -0,0 +1,380 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/s3"
+)
+
+func resourceAwsS3BucketObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectPut,
+		Read:   resourceAwsS3BucketObjectRead,
+		Update: resourceAwsS3BucketObjectPut,
+		Delete: resourceAwsS3BucketObjectDelete,
+
+		CustomizeDiff: resourceAwsS3BucketObjectCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content", "content_base64"},
+			},
+
+			"content": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source", "content_base64"},
+			},
+
+			"content_base64": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source", "content"},
+			},
+
+			"acl": &schema.Schema{
+				Type:     schema.TypeString,
+				Default:  "private",
+				Optional: true,
+			},
+
+			"cache_control": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_encoding": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_disposition": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_language": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"server_side_encryption": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"kms_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"storage_class": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"etag": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"version_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	var body io.ReadSeeker
+
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		file, err := os.Open(source)
+		if err != nil {
+			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
+		}
+		defer file.Close()
+		body = file
+	} else if v, ok := d.GetOk("content"); ok {
+		body = bytes.NewReader([]byte(v.(string)))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return fmt.Errorf("error decoding content_base64: %s", err)
+		}
+		body = bytes.NewReader(content)
+	} else {
+		return fmt.Errorf("Must specify \"source\", \"content\", or \"content_base64\" field")
+	}
+
+	putInput := &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		ACL:    aws.String(d.Get("acl").(string)),
+		Body:   body,
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		putInput.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		putInput.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_type"); ok {
+		putInput.ContentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_encoding"); ok {
+		putInput.ContentEncoding = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_disposition"); ok {
+		putInput.ContentDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_language"); ok {
+		putInput.ContentLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		putInput.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		putInput.SSEKMSKeyID = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		putInput.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] S3 put object: bucket %s, key %s", bucket, key)
+
+	resp, err := s3conn.PutObject(putInput)
+	if err != nil {
+		return fmt.Errorf("Error putting S3 object: %s", err)
+	}
+
+	if resp.VersionID != nil {
+		d.Set("version_id", *resp.VersionID)
+	}
+
+	d.SetId(key)
+
+	if err := setTagsS3Object(s3conn, bucket, key, d.Get("tags").(map[string]interface{})); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Id()
+
+	resp, err := s3conn.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if awsError, ok := err.(aws.APIError); ok && awsError.StatusCode == 404 {
+			log.Printf("[WARN] S3 bucket object (%s) not found, removing from state", key)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket object (%s): %s", key, err)
+	}
+
+	if resp.ETag != nil {
+		d.Set("etag", cleanEtag(*resp.ETag))
+	}
+
+	if resp.ContentType != nil {
+		d.Set("content_type", *resp.ContentType)
+	}
+
+	if resp.StorageClass != nil {
+		d.Set("storage_class", *resp.StorageClass)
+	} else {
+		d.Set("storage_class", s3.ObjectStorageClassStandard)
+	}
+
+	if resp.VersionID != nil {
+		d.Set("version_id", *resp.VersionID)
+	}
+
+	tagSet, err := getTagSetS3Object(s3conn, bucket, key)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("tags", tagsToMapS3(tagSet)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Id()
+
+	log.Printf("[DEBUG] S3 delete object: bucket %s, key %s", bucket, key)
+
+	_, err := s3conn.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting S3 object: %s", err)
+	}
+
+	return nil
+}
+
+// cleanEtag strips the surrounding quotes S3 wraps single-part ETags in.
+func cleanEtag(etag string) string {
+	return strings.Trim(etag, `"`)
+}
+
+// setTagsS3Object sets an object's tags, unlike setTagsS3 which tags the
+// bucket itself - objects are tagged via PutObjectTagging/DeleteObjectTagging
+// keyed on bucket+key, not through the bucket tagging API.
+func setTagsS3Object(s3conn *s3.S3, bucket, key string, tags map[string]interface{}) error {
+	if len(tags) == 0 {
+		_, err := s3conn.DeleteObjectTagging(&s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting S3 object tags: %s", err)
+		}
+		return nil
+	}
+
+	tagSet := make([]*s3.Tag, 0, len(tags))
+	for k, v := range tags {
+		tagSet = append(tagSet, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	_, err := s3conn.PutObjectTagging(&s3.PutObjectTaggingInput{
+		Bucket:  aws.String(bucket),
+		Key:     aws.String(key),
+		Tagging: &s3.Tagging{TagSet: tagSet},
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 object tags: %s", err)
+	}
+
+	return nil
+}
+
+// getTagSetS3Object is the object-level counterpart to getTagSetS3.
+func getTagSetS3Object(s3conn *s3.S3, bucket, key string) ([]*s3.Tag, error) {
+	resp, err := s3conn.GetObjectTagging(&s3.GetObjectTaggingInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting S3 object tags: %s", err)
+	}
+
+	return resp.TagSet, nil
+}
+
+// resourceAwsS3BucketObjectCustomizeDiff recomputes the local file's hash at
+// plan time and compares it against the "etag" stored in state, since
+// "source" itself doesn't change when a user edits the file it points to.
+// A mismatch marks "etag" as known-after-apply, which surfaces a diff and
+// drives Update/Create to re-upload the object.
+func resourceAwsS3BucketObjectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	source, ok := d.GetOk("source")
+	if !ok {
+		return nil
+	}
+
+	hash, err := fileMD5(source.(string))
+	if err != nil {
+		// The source file may not exist yet (e.g. it's generated by another
+		// resource earlier in the same apply); let Create/Update surface
+		// that error instead of failing the plan.
+		return nil
+	}
+
+	if d.Get("etag").(string) != hash {
+		return d.SetNewComputed("etag")
+	}
+
+	return nil
+}
+
+// fileMD5 returns the hex-encoded MD5 digest of the file at path, matching
+// the format S3 uses for single-part object ETags.
+func fileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
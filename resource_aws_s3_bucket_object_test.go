@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestCleanEtag(t *testing.T) {
+	cases := map[string]string{
+		`"abc123"`: "abc123",
+		"abc123":   "abc123",
+		`""`:       "",
+	}
+
+	for in, want := range cases {
+		if got := cleanEtag(in); got != want {
+			t.Errorf("cleanEtag(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFileMD5(t *testing.T) {
+	f, err := ioutil.TempFile("", "s3-bucket-object-test")
+	if err != nil {
+		t.Fatalf("failed to create temp file: %s", err)
+	}
+	defer os.Remove(f.Name())
+
+	content := []byte("hello world")
+	if _, err := f.Write(content); err != nil {
+		t.Fatalf("failed to write temp file: %s", err)
+	}
+	f.Close()
+
+	got, err := fileMD5(f.Name())
+	if err != nil {
+		t.Fatalf("fileMD5 returned error: %s", err)
+	}
+
+	want := fmt.Sprintf("%x", md5.Sum(content))
+	if got != want {
+		t.Errorf("fileMD5() = %q, want %q", got, want)
+	}
+
+	if _, err := fileMD5(f.Name() + "-does-not-exist"); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+}
@@ -0,0 +1,235 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/s3"
+)
+
+func TestExpandFlattenWebsiteRoutingRules(t *testing.T) {
+	raw := `[{"Condition":{"KeyPrefixEquals":"docs/"},"Redirect":{"ReplaceKeyPrefixWith":"documents/"}}]`
+
+	rules, err := expandWebsiteRoutingRules(raw)
+	if err != nil {
+		t.Fatalf("expandWebsiteRoutingRules returned error: %s", err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(rules))
+	}
+	if *rules[0].Condition.KeyPrefixEquals != "docs/" {
+		t.Fatalf("expected condition to survive expansion, got %#v", rules[0].Condition)
+	}
+
+	flattened, err := flattenWebsiteRoutingRules(rules)
+	if err != nil {
+		t.Fatalf("flattenWebsiteRoutingRules returned error: %s", err)
+	}
+
+	roundTripped, err := expandWebsiteRoutingRules(flattened)
+	if err != nil {
+		t.Fatalf("expandWebsiteRoutingRules on flattened JSON returned error: %s", err)
+	}
+	if !reflect.DeepEqual(rules, roundTripped) {
+		t.Fatalf("round-trip mismatch:\n got: %#v\nwant: %#v", roundTripped, rules)
+	}
+}
+
+func TestExpandFlattenWebsiteRoutingRulesFromList(t *testing.T) {
+	rulesRaw := []interface{}{
+		map[string]interface{}{
+			"condition": []interface{}{
+				map[string]interface{}{
+					"key_prefix_equals":               "docs/",
+					"http_error_code_returned_equals": "",
+				},
+			},
+			"redirect": []interface{}{
+				map[string]interface{}{
+					"replace_key_prefix_with": "documents/",
+					"host_name":               "",
+					"protocol":                "",
+					"replace_key_with":        "",
+					"http_redirect_code":      "",
+				},
+			},
+		},
+	}
+
+	rules := expandWebsiteRoutingRulesFromList(rulesRaw)
+	if len(rules) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(rules))
+	}
+	if *rules[0].Condition.KeyPrefixEquals != "docs/" {
+		t.Fatalf("expected condition to survive expansion, got %#v", rules[0].Condition)
+	}
+	if *rules[0].Redirect.ReplaceKeyPrefixWith != "documents/" {
+		t.Fatalf("expected redirect to survive expansion, got %#v", rules[0].Redirect)
+	}
+
+	flattened := flattenWebsiteRoutingRulesToList(rules)
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 flattened rule, got %d", len(flattened))
+	}
+	if flattened[0]["redirect"].([]map[string]interface{})[0]["replace_key_prefix_with"] != "documents/" {
+		t.Fatalf("unexpected flattened redirect: %#v", flattened[0]["redirect"])
+	}
+}
+
+func TestUsedRoutingRuleBlock(t *testing.T) {
+	cases := []struct {
+		name     string
+		existing []interface{}
+		want     bool
+	}{
+		{"nil", nil, false},
+		{"empty website block", []interface{}{nil}, false},
+		{
+			"routing_rules JSON configured",
+			[]interface{}{map[string]interface{}{"routing_rules": "[]"}},
+			false,
+		},
+		{
+			"routing_rule block configured",
+			[]interface{}{map[string]interface{}{"routing_rule": []interface{}{map[string]interface{}{}}}},
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		if got := usedRoutingRuleBlock(c.existing); got != c.want {
+			t.Errorf("%s: usedRoutingRuleBlock() = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestExpandWebsiteRedirect(t *testing.T) {
+	redirect, err := expandWebsiteRedirect("https://example.com")
+	if err != nil {
+		t.Fatalf("expandWebsiteRedirect returned error: %s", err)
+	}
+	if *redirect.HostName != "example.com" {
+		t.Errorf("expected host name %q, got %q", "example.com", *redirect.HostName)
+	}
+	if *redirect.Protocol != "https" {
+		t.Errorf("expected protocol %q, got %q", "https", *redirect.Protocol)
+	}
+
+	bareHost, err := expandWebsiteRedirect("example.com")
+	if err != nil {
+		t.Fatalf("expandWebsiteRedirect returned error: %s", err)
+	}
+	if bareHost.Protocol != nil {
+		t.Errorf("expected no protocol for bare host, got %q", *bareHost.Protocol)
+	}
+
+	if flattenWebsiteRedirect(redirect) != "https://example.com" {
+		t.Errorf("flattenWebsiteRedirect round-trip mismatch: %s", flattenWebsiteRedirect(redirect))
+	}
+}
+
+func TestFlattenVersioning(t *testing.T) {
+	v := flattenVersioning(&s3.GetBucketVersioningOutput{
+		Status:    aws.String(s3.BucketVersioningStatusEnabled),
+		MFADelete: aws.String(s3.MFADeleteStatusEnabled),
+	})
+
+	if len(v) != 1 {
+		t.Fatalf("expected 1 element, got %d", len(v))
+	}
+	if v[0]["enabled"] != true || v[0]["mfa_delete"] != true {
+		t.Errorf("unexpected flattened versioning: %#v", v[0])
+	}
+
+	if flattenVersioning(&s3.GetBucketVersioningOutput{}) != nil {
+		t.Errorf("expected nil for an unconfigured versioning response")
+	}
+}
+
+func TestFlattenLogging(t *testing.T) {
+	l := flattenLogging(&s3.GetBucketLoggingOutput{
+		LoggingEnabled: &s3.LoggingEnabled{
+			TargetBucket: aws.String("log-bucket"),
+			TargetPrefix: aws.String("logs/"),
+		},
+	})
+
+	if len(l) != 1 {
+		t.Fatalf("expected 1 element, got %d", len(l))
+	}
+	if l[0]["target_bucket"] != "log-bucket" || l[0]["target_prefix"] != "logs/" {
+		t.Errorf("unexpected flattened logging: %#v", l[0])
+	}
+
+	if flattenLogging(&s3.GetBucketLoggingOutput{}) != nil {
+		t.Errorf("expected nil when logging is not enabled")
+	}
+}
+
+func TestFlattenLifecycleRules(t *testing.T) {
+	rules := []*s3.LifecycleRule{
+		{
+			ID:     aws.String("expire-old"),
+			Prefix: aws.String("tmp/"),
+			Status: aws.String(s3.ExpirationStatusEnabled),
+			Expiration: &s3.LifecycleExpiration{
+				Days: aws.Long(30),
+			},
+			Transitions: []*s3.Transition{
+				{Days: aws.Long(10), StorageClass: aws.String("GLACIER")},
+			},
+		},
+	}
+
+	flattened := flattenLifecycleRules(rules)
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(flattened))
+	}
+
+	r := flattened[0]
+	if r["id"] != "expire-old" || r["prefix"] != "tmp/" || r["enabled"] != true {
+		t.Errorf("unexpected flattened rule: %#v", r)
+	}
+
+	expiration := r["expiration"].([]map[string]interface{})
+	if expiration[0]["days"] != 30 {
+		t.Errorf("expected expiration days 30, got %#v", expiration[0])
+	}
+
+	transitions := r["transition"].([]map[string]interface{})
+	if transitions[0]["storage_class"] != "GLACIER" {
+		t.Errorf("expected transition storage_class GLACIER, got %#v", transitions[0])
+	}
+
+	if flattenLifecycleRules(nil) != nil {
+		t.Errorf("expected nil for no rules")
+	}
+}
+
+func TestFlattenCorsRules(t *testing.T) {
+	rules := []*s3.CORSRule{
+		{
+			AllowedMethods: []*string{aws.String("GET")},
+			AllowedOrigins: []*string{aws.String("*")},
+			MaxAgeSeconds:  aws.Long(3600),
+		},
+	}
+
+	flattened := flattenCorsRules(rules)
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(flattened))
+	}
+
+	r := flattened[0]
+	if r["max_age_seconds"] != 3600 {
+		t.Errorf("expected max_age_seconds 3600, got %#v", r["max_age_seconds"])
+	}
+	if !reflect.DeepEqual(r["allowed_methods"], []interface{}{"GET"}) {
+		t.Errorf("unexpected allowed_methods: %#v", r["allowed_methods"])
+	}
+
+	if flattenCorsRules(nil) != nil {
+		t.Errorf("expected nil for no rules")
+	}
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+)
+
+// expandStringList converts a TypeList of strings, as returned by
+// ResourceData, into a slice of *string suitable for AWS SDK request
+// structs.
+func expandStringList(list []interface{}) []*string {
+	result := make([]*string, 0, len(list))
+	for _, v := range list {
+		result = append(result, aws.String(v.(string)))
+	}
+	return result
+}
+
+// flattenStringList is the inverse of expandStringList, used when
+// populating ResourceData from an AWS SDK response.
+func flattenStringList(list []*string) []interface{} {
+	result := make([]interface{}, 0, len(list))
+	for _, v := range list {
+		result = append(result, *v)
+	}
+	return result
+}
+
+// stringMapToPointers converts a TypeMap of strings into the map of
+// *string AWS SDK request structs expect (e.g. S3 object metadata).
+func stringMapToPointers(m map[string]interface{}) map[string]*string {
+	result := make(map[string]*string, len(m))
+	for k, v := range m {
+		result[k] = aws.String(v.(string))
+	}
+	return result
+}
+
+// normalizeJson returns a semantically-equivalent, minified rendering of a
+// JSON document so that whitespace-only differences (e.g. Terraform config
+// vs. what an API echoes back) don't show up as diffs. Invalid JSON is
+// returned unmodified.
+func normalizeJson(jsonString interface{}) string {
+	if jsonString == nil || jsonString == "" {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(jsonString.(string))); err != nil {
+		return jsonString.(string)
+	}
+
+	return buf.String()
+}
+
+// suppressEquivalentJsonDiffs is a DiffSuppressFunc for JSON document fields
+// (e.g. IAM/bucket policies): it suppresses the diff when old and new are
+// whitespace-only variations of the same document, without rewriting the
+// stored state value.
+func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeJson(old) == normalizeJson(new)
+}
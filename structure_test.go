@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeJson(t *testing.T) {
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{`{"Version": "2012-10-17"}`, `{"Version":"2012-10-17"}`},
+		{`{}`, `{}`},
+		{"", ""},
+		{"not json", "not json"},
+	}
+
+	for _, c := range cases {
+		if got := normalizeJson(c.input); got != c.want {
+			t.Errorf("normalizeJson(%q) = %q, want %q", c.input, got, c.want)
+		}
+	}
+
+	if got := normalizeJson(nil); got != "" {
+		t.Errorf("normalizeJson(nil) = %q, want empty string", got)
+	}
+}
+
+func TestSuppressEquivalentJsonDiffs(t *testing.T) {
+	old := `{"Version": "2012-10-17", "Statement": []}`
+	equivalent := "{\"Version\":\"2012-10-17\",\"Statement\":[]}"
+	different := `{"Version": "2008-10-17", "Statement": []}`
+
+	if !suppressEquivalentJsonDiffs("policy", old, equivalent, nil) {
+		t.Errorf("expected whitespace-only variations to suppress the diff")
+	}
+
+	if suppressEquivalentJsonDiffs("policy", old, different, nil) {
+		t.Errorf("expected a semantic change to not suppress the diff")
+	}
+}
+
+func TestExpandFlattenStringList(t *testing.T) {
+	raw := []interface{}{"a", "b", "c"}
+
+	expanded := expandStringList(raw)
+	if len(expanded) != 3 {
+		t.Fatalf("expected 3 elements, got %d", len(expanded))
+	}
+
+	flattened := flattenStringList(expanded)
+	if !reflect.DeepEqual(flattened, raw) {
+		t.Errorf("round-trip mismatch:\n got: %#v\nwant: %#v", flattened, raw)
+	}
+}
+
+func TestStringMapToPointers(t *testing.T) {
+	raw := map[string]interface{}{"foo": "bar"}
+
+	result := stringMapToPointers(raw)
+	if len(result) != 1 || *result["foo"] != "bar" {
+		t.Errorf("unexpected result: %#v", result)
+	}
+}